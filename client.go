@@ -0,0 +1,321 @@
+package zillow
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+// defaultUserAgent is sent on every request unless overridden with WithUserAgent.
+const defaultUserAgent = "go-zillow"
+
+// Credentials holds the API key Zillow issues per application (ZWSID).
+type Credentials struct {
+	ZWSID string
+}
+
+// FromEnv builds Credentials from the ZWSID environment variable.
+func FromEnv() (Credentials, error) {
+	zwsid := os.Getenv("ZWSID")
+	if zwsid == "" {
+		return Credentials{}, errors.New("zillow: ZWSID environment variable not set")
+	}
+	return Credentials{ZWSID: zwsid}, nil
+}
+
+// Client is a Zillow API client. Construct one with NewClient.
+type Client struct {
+	creds      Credentials
+	baseURL    string
+	userAgent  string
+	httpClient *http.Client
+	retries    int
+	backoff    time.Duration
+
+	// Property, Neighborhood, Mortgage, and Stream group the less commonly
+	// used parts of the API that don't belong on Client directly.
+	Property     *PropertyService
+	Neighborhood *NeighborhoodService
+	Mortgage     *MortgageService
+	Stream       *StreamService
+}
+
+// Opt configures a Client at construction time.
+type Opt func(*Client) error
+
+// WithHTTPClient overrides the *http.Client used to make requests.
+// The default is http.DefaultClient.
+func WithHTTPClient(hc *http.Client) Opt {
+	return func(c *Client) error {
+		if hc == nil {
+			return errors.New("zillow: WithHTTPClient requires a non-nil client")
+		}
+		c.httpClient = hc
+		return nil
+	}
+}
+
+// WithBaseURL overrides the API base URL, mainly useful for testing against
+// a local fixture server.
+func WithBaseURL(baseURL string) Opt {
+	return func(c *Client) error {
+		if baseURL == "" {
+			return errors.New("zillow: WithBaseURL requires a non-empty URL")
+		}
+		c.baseURL = baseURL
+		return nil
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Opt {
+	return func(c *Client) error {
+		if userAgent == "" {
+			return errors.New("zillow: WithUserAgent requires a non-empty string")
+		}
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithRetry enables up to n retries of a failed request, waiting backoff
+// between attempts. A request is retried on transport errors, non-2xx HTTP
+// statuses, and ErrServiceUnavailable; other API errors (including
+// ErrRateLimited, which another attempt can't fix) are returned immediately.
+func WithRetry(n int, backoff time.Duration) Opt {
+	return func(c *Client) error {
+		if n < 0 {
+			return errors.New("zillow: WithRetry requires a non-negative retry count")
+		}
+		c.retries = n
+		c.backoff = backoff
+		return nil
+	}
+}
+
+// NewClient creates a Zillow API client from the given credentials, applying
+// any options in order.
+func NewClient(creds Credentials, opts ...Opt) (*Client, error) {
+	if creds.ZWSID == "" {
+		return nil, errors.New("zillow: credentials missing ZWSID")
+	}
+
+	c := &Client{
+		creds:      creds,
+		baseURL:    baseUrl,
+		userAgent:  defaultUserAgent,
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	c.Property = &PropertyService{client: c}
+	c.Neighborhood = &NeighborhoodService{client: c}
+	c.Mortgage = &MortgageService{client: c}
+	c.Stream = &StreamService{
+		client:     c,
+		seenComps:  map[string]map[string]bool{},
+		seenSearch: map[string]map[string]bool{},
+	}
+
+	return c, nil
+}
+
+// get performs a GET against servicePath with the given query values,
+// decoding the XML response body into result. It retries according to the
+// client's WithRetry configuration and respects ctx cancellation.
+func (c *Client) get(ctx context.Context, servicePath string, values url.Values, result interface{}) error {
+	reqURL := c.baseURL + "/" + servicePath + ".htm?" + values.Encode()
+
+	var lastErr error
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(c.backoff):
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("User-Agent", c.userAgent)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		err = xml.NewDecoder(resp.Body).Decode(result)
+		httpStatus := resp.StatusCode
+		resp.Body.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if httpStatus < 200 || httpStatus >= 300 {
+			lastErr = fmt.Errorf("zillow: unexpected HTTP status %d", httpStatus)
+			continue
+		}
+
+		if msg, ok := messageOf(result); ok {
+			if msgErr := checkMessage(msg, httpStatus); msgErr != nil {
+				if isRetryable(msgErr) {
+					lastErr = msgErr
+					continue
+				}
+				return msgErr
+			}
+		}
+		return nil
+	}
+	return lastErr
+}
+
+// isRetryable reports whether err represents a condition another attempt
+// might succeed past. ErrServiceUnavailable is transient by definition
+// ("service currently unavailable"); ErrRateLimited is not, since it means
+// the day's call allowance is already spent and waiting c.backoff won't
+// change that.
+func isRetryable(err error) bool {
+	return errors.Is(err, ErrServiceUnavailable)
+}
+
+// zwsID is the zws-Id query parameter every call needs.
+func (c *Client) zwsID() string {
+	return c.creds.ZWSID
+}
+
+// GetZestimateCtx is the context-aware form of GetZestimate.
+func (c *Client) GetZestimateCtx(ctx context.Context, request ZestimateRequest) (*ZestimateResult, error) {
+	values := url.Values{
+		zwsIdParam:         {c.zwsID()},
+		zpidParam:          {request.Zpid},
+		rentzestimateParam: {strconv.FormatBool(request.Rentzestimate)},
+	}
+	var result ZestimateResult
+	err := c.get(ctx, getZestimatePath, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetZestimate fetches the current Zestimate for the given property.
+func (c *Client) GetZestimate(request ZestimateRequest) (*ZestimateResult, error) {
+	return c.GetZestimateCtx(context.Background(), request)
+}
+
+// GetSearchResultsCtx is the context-aware form of GetSearchResults.
+func (c *Client) GetSearchResultsCtx(ctx context.Context, request SearchRequest) (*SearchResults, error) {
+	values := url.Values{
+		zwsIdParam:         {c.zwsID()},
+		addressParam:       {request.Address},
+		cityStateZipParam:  {request.CityStateZip},
+		rentzestimateParam: {strconv.FormatBool(request.Rentzestimate)},
+	}
+	var result SearchResults
+	err := c.get(ctx, getSearchResults, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetSearchResults looks up properties matching the given address.
+func (c *Client) GetSearchResults(request SearchRequest) (*SearchResults, error) {
+	return c.GetSearchResultsCtx(context.Background(), request)
+}
+
+// GetChartCtx is the context-aware form of GetChart.
+func (c *Client) GetChartCtx(ctx context.Context, request ChartRequest) (*ChartResult, error) {
+	values := url.Values{
+		zwsIdParam:         {c.zwsID()},
+		zpidParam:          {request.Zpid},
+		unitTypeParam:      {request.UnitType},
+		widthParam:         {strconv.Itoa(request.Width)},
+		heightParam:        {strconv.Itoa(request.Height)},
+		chartDurationParam: {request.Duration},
+	}
+	var result ChartResult
+	err := c.get(ctx, getChart, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetChart renders a Zestimate history chart image URL for the property.
+func (c *Client) GetChart(request ChartRequest) (*ChartResult, error) {
+	return c.GetChartCtx(context.Background(), request)
+}
+
+// GetCompsCtx is the context-aware form of GetComps.
+func (c *Client) GetCompsCtx(ctx context.Context, request CompsRequest) (*CompsResult, error) {
+	values := url.Values{
+		zwsIdParam:         {c.zwsID()},
+		zpidParam:          {request.Zpid},
+		countParam:         {strconv.Itoa(request.Count)},
+		rentzestimateParam: {strconv.FormatBool(request.Rentzestimate)},
+	}
+	var result CompsResult
+	err := c.get(ctx, getComps, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetComps returns the principal property plus its comparables.
+func (c *Client) GetComps(request CompsRequest) (*CompsResult, error) {
+	return c.GetCompsCtx(context.Background(), request)
+}
+
+// zillow adapts a Client to the legacy Zillow interface.
+type zillow struct {
+	client *Client
+}
+
+// NewZillow creates a new Zillow client from a bare ZWSID.
+//
+// Deprecated: use NewClient, which supports options like WithHTTPClient,
+// WithRetry, and context-aware Ctx methods.
+func NewZillow(zwsId string) Zillow {
+	c, err := NewClient(Credentials{ZWSID: zwsId})
+	if err != nil {
+		// NewClient only fails on a missing ZWSID, which can't happen here
+		// unless the caller passed an empty string; fall back to a client
+		// that will surface the error on first call.
+		c = &Client{creds: Credentials{ZWSID: zwsId}, baseURL: baseUrl, userAgent: defaultUserAgent, httpClient: http.DefaultClient}
+	}
+	return &zillow{client: c}
+}
+
+func (z *zillow) GetZestimate(request ZestimateRequest) (*ZestimateResult, error) {
+	return z.client.GetZestimate(request)
+}
+
+func (z *zillow) GetSearchResults(request SearchRequest) (*SearchResults, error) {
+	return z.client.GetSearchResults(request)
+}
+
+func (z *zillow) GetChart(request ChartRequest) (*ChartResult, error) {
+	return z.client.GetChart(request)
+}
+
+func (z *zillow) GetComps(request CompsRequest) (*CompsResult, error) {
+	return z.client.GetComps(request)
+}
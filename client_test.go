@@ -0,0 +1,256 @@
+package zillow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewClientRequiresZWSID(t *testing.T) {
+	if _, err := NewClient(Credentials{}); err == nil {
+		t.Fatal("got nil error, want one for a missing ZWSID")
+	}
+}
+
+func TestNewClientDefaults(t *testing.T) {
+	c, err := NewClient(Credentials{ZWSID: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.baseURL != baseUrl {
+		t.Errorf("baseURL = %q, want %q", c.baseURL, baseUrl)
+	}
+	if c.userAgent != defaultUserAgent {
+		t.Errorf("userAgent = %q, want %q", c.userAgent, defaultUserAgent)
+	}
+	if c.httpClient != http.DefaultClient {
+		t.Error("httpClient = a non-default client, want http.DefaultClient")
+	}
+	if c.Property == nil || c.Neighborhood == nil || c.Mortgage == nil || c.Stream == nil {
+		t.Error("NewClient left a sub-service nil")
+	}
+}
+
+func TestOpts(t *testing.T) {
+	hc := &http.Client{}
+	c, err := NewClient(Credentials{ZWSID: "test"},
+		WithHTTPClient(hc),
+		WithBaseURL("http://example.test"),
+		WithUserAgent("custom-agent"),
+		WithRetry(2, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.httpClient != hc {
+		t.Error("WithHTTPClient was not applied")
+	}
+	if c.baseURL != "http://example.test" {
+		t.Errorf("baseURL = %q, want http://example.test", c.baseURL)
+	}
+	if c.userAgent != "custom-agent" {
+		t.Errorf("userAgent = %q, want custom-agent", c.userAgent)
+	}
+	if c.retries != 2 || c.backoff != time.Millisecond {
+		t.Errorf("retries/backoff = %d/%v, want 2/1ms", c.retries, c.backoff)
+	}
+}
+
+func TestOptValidation(t *testing.T) {
+	cases := []struct {
+		name string
+		opt  Opt
+	}{
+		{"WithHTTPClient nil", WithHTTPClient(nil)},
+		{"WithBaseURL empty", WithBaseURL("")},
+		{"WithUserAgent empty", WithUserAgent("")},
+		{"WithRetry negative", WithRetry(-1, 0)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := NewClient(Credentials{ZWSID: "test"}, c.opt); err == nil {
+				t.Fatal("got nil error, want a validation error")
+			}
+		})
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("ZWSID", "")
+	if _, err := FromEnv(); err == nil {
+		t.Fatal("got nil error, want one when ZWSID is unset")
+	}
+
+	t.Setenv("ZWSID", "abc123")
+	creds, err := FromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.ZWSID != "abc123" {
+		t.Errorf("ZWSID = %q, want abc123", creds.ZWSID)
+	}
+}
+
+func TestGetRetriesOnTransportError(t *testing.T) {
+	var calls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/GetZestimate.htm", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// Close the connection without a response to force a client-side
+			// transport error on the first attempt.
+			hj, ok := w.(http.Hijacker)
+			if !ok {
+				t.Fatal("ResponseWriter does not support hijacking")
+			}
+			conn, _, err := hj.Hijack()
+			if err != nil {
+				t.Fatal(err)
+			}
+			conn.Close()
+			return
+		}
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte("<zestimate><message><code>0</code></message><response><zestimate><amount currency=\"USD\">200000</amount></zestimate></response></zestimate>"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL), WithRetry(1, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.GetZestimate(ZestimateRequest{Zpid: "1"})
+	if err != nil {
+		t.Fatalf("unexpected error after retry: %v", err)
+	}
+	if result.Zestimate.Amount.Value != 200000 {
+		t.Errorf("Amount.Value = %d, want 200000", result.Zestimate.Amount.Value)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2 (one failure, one retry)", calls)
+	}
+}
+
+func TestGetRetriesOnServiceUnavailableNotOnRateLimit(t *testing.T) {
+	cases := []struct {
+		name      string
+		code      int
+		wantCalls int32
+		wantErr   error
+	}{
+		{"service unavailable retries then succeeds", 2, 2, nil},
+		{"rate limited returns immediately", 7, 1, ErrRateLimited},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var calls int32
+			mux := http.NewServeMux()
+			mux.HandleFunc("/GetZestimate.htm", func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&calls, 1)
+				w.Header().Set("Content-Type", "text/xml")
+				if n == 1 {
+					w.Write([]byte("<zestimate><message><code>" + strconv.Itoa(c.code) + "</code></message></zestimate>"))
+					return
+				}
+				w.Write([]byte("<zestimate><message><code>0</code></message><response><zestimate><amount currency=\"USD\">200000</amount></zestimate></response></zestimate>"))
+			})
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL), WithRetry(1, time.Millisecond))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = client.GetZestimate(ZestimateRequest{Zpid: "1"})
+			if c.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if c.wantErr != nil && !errors.Is(err, c.wantErr) {
+				t.Fatalf("got %v, want %v", err, c.wantErr)
+			}
+			if calls != c.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, c.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGetRetriesOnNon2xxStatus(t *testing.T) {
+	var calls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/GetZestimate.htm", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "text/xml")
+		if n == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<zestimate><message><code>0</code></message></zestimate>"))
+			return
+		}
+		w.Write([]byte("<zestimate><message><code>0</code></message><response><zestimate><amount currency=\"USD\">200000</amount></zestimate></response></zestimate>"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL), WithRetry(1, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetZestimate(ZestimateRequest{Zpid: "1"}); err != nil {
+		t.Fatalf("unexpected error after retry: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2 (one 503, one retry)", calls)
+	}
+}
+
+func TestGetHonorsContextCancellationBetweenRetries(t *testing.T) {
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL("http://127.0.0.1:0"), WithRetry(3, time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		// Let the first attempt fail before cancelling, so the retry loop
+		// is actually waiting on ctx.Done() rather than the backoff timer.
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err = client.GetZestimateCtx(ctx, ZestimateRequest{Zpid: "1"})
+	if err == nil {
+		t.Fatal("got nil error, want one from the cancelled context or a dial failure")
+	}
+}
+
+func TestNewZillowBackCompat(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte("<zestimate><message><code>0</code></message><response><zestimate><amount currency=\"USD\">200000</amount></zestimate></response></zestimate>"))
+	}))
+	defer srv.Close()
+
+	z := NewZillow("test")
+	zc, ok := z.(*zillow)
+	if !ok {
+		t.Fatalf("NewZillow returned %T, want *zillow", z)
+	}
+	zc.client.baseURL = srv.URL
+
+	result, err := z.GetZestimate(ZestimateRequest{Zpid: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Zestimate.Amount.Value != 200000 {
+		t.Errorf("Amount.Value = %d, want 200000", result.Zestimate.Amount.Value)
+	}
+}
@@ -0,0 +1,289 @@
+package zillow
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// newEchoServer serves body for every request to pattern and hands the
+// request's query values to the test via gotValues, so a test can assert on
+// how an endpoint built its query string.
+func newEchoServer(t *testing.T, pattern, body string, gotValues *url.Values) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		*gotValues = r.URL.Query()
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(body))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetUpdatedPropertyDetails(t *testing.T) {
+	var got url.Values
+	srv := newEchoServer(t, "/GetUpdatedPropertyDetails.htm", `<updatedPropertyDetails>
+  <message><code>0</code></message>
+  <response>
+    <images><image>http://example.test/1.jpg</image></images>
+    <priceHistory>
+      <record date="2020-01-01" event="Sold" price="200000" priceSqFt="200" source="Public Record"/>
+    </priceHistory>
+  </response>
+</updatedPropertyDetails>`, &got)
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Property.GetUpdatedPropertyDetails(UpdatedPropertyDetailsRequest{Zpid: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Get(zpidParam) != "1" {
+		t.Errorf("zpid param = %q, want 1", got.Get(zpidParam))
+	}
+	if len(result.PriceHistory) != 1 || result.PriceHistory[0].Price != 200000 {
+		t.Errorf("PriceHistory = %+v, want one record priced 200000", result.PriceHistory)
+	}
+}
+
+func TestGetDeepSearchResults(t *testing.T) {
+	var got url.Values
+	srv := newEchoServer(t, "/GetDeepSearchResults.htm", `<searchresults>
+  <message><code>0</code></message>
+  <response>
+    <results>
+      <result>
+        <zpid>1</zpid>
+        <editedFacts><bedrooms>3</bedrooms></editedFacts>
+      </result>
+    </results>
+  </response>
+</searchresults>`, &got)
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Property.GetDeepSearchResults(SearchRequest{Address: "1 Main St", CityStateZip: "Anytown, ST"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Get(addressParam) != "1 Main St" || got.Get(cityStateZipParam) != "Anytown, ST" {
+		t.Errorf("query = %v, want address/citystatezip set", got)
+	}
+	if len(result.Results) != 1 || result.Results[0].EditedFacts.Beds != 3 {
+		t.Errorf("Results = %+v, want one result with 3 bedrooms", result.Results)
+	}
+}
+
+func TestGetDeepComps(t *testing.T) {
+	var got url.Values
+	srv := newEchoServer(t, "/GetDeepComps.htm", `<comps>
+  <message><code>0</code></message>
+  <response>
+    <properties>
+      <principal><zpid>1</zpid></principal>
+      <comparables>
+        <comp score="0.5"><zpid>2</zpid></comp>
+      </comparables>
+    </properties>
+  </response>
+</comps>`, &got)
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Property.GetDeepComps(CompsRequest{Zpid: "1", Count: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Get(countParam) != "5" {
+		t.Errorf("count param = %q, want 5", got.Get(countParam))
+	}
+	if result.Principal.Zpid != "1" || len(result.Comparables) != 1 || result.Comparables[0].Zpid != "2" {
+		t.Errorf("got %+v, want principal zpid 1 and one comparable zpid 2", result)
+	}
+}
+
+func TestGetRegionChildren(t *testing.T) {
+	var got url.Values
+	srv := newEchoServer(t, "/GetRegionChildren.htm", `<regionchildren>
+  <message><code>0</code></message>
+  <response>
+    <region><id>1</id><name>Anytown</name></region>
+    <list>
+      <region><id>2</id><name>Downtown</name></region>
+    </list>
+  </response>
+</regionchildren>`, &got)
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Neighborhood.GetRegionChildren(RegionChildrenRequest{State: "ST", City: "Anytown", ChildType: "neighborhood"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Get(stateParam) != "ST" || got.Get(cityParam) != "Anytown" || got.Get(childtypeParam) != "neighborhood" {
+		t.Errorf("query = %v, want state/city/childtype set", got)
+	}
+	if len(result.Children) != 1 || result.Children[0].Name != "Downtown" {
+		t.Errorf("Children = %+v, want one child named Downtown", result.Children)
+	}
+}
+
+func TestGetRegionChart(t *testing.T) {
+	var got url.Values
+	srv := newEchoServer(t, "/GetRegionChart.htm", `<regionchart>
+  <message><code>0</code></message>
+  <response>
+    <url>http://example.test/chart.gif</url>
+    <zindex>300000</zindex>
+  </response>
+</regionchart>`, &got)
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Neighborhood.GetRegionChart(RegionChartRequest{Zip: "90210", Width: 300, Height: 150})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Get(zipParam) != "90210" || got.Get(widthParam) != "300" || got.Get(heightParam) != "150" {
+		t.Errorf("query = %v, want zip/width/height set", got)
+	}
+	if result.Url != "http://example.test/chart.gif" {
+		t.Errorf("Url = %q, want http://example.test/chart.gif", result.Url)
+	}
+}
+
+func TestGetRateSummary(t *testing.T) {
+	var got url.Values
+	srv := newEchoServer(t, "/GetRateSummary.htm", `<rateSummary>
+  <message><code>0</code></message>
+  <response>
+    <today>
+      <rates>
+        <rate loanType="thirtyYearFixed">4.5</rate>
+      </rates>
+    </today>
+  </response>
+</rateSummary>`, &got)
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Mortgage.GetRateSummary(RateSummaryRequest{State: "CA"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Get(stateParam) != "CA" {
+		t.Errorf("state param = %q, want CA", got.Get(stateParam))
+	}
+	if len(result.Rates) != 1 || result.Rates[0].LoanType != "thirtyYearFixed" || result.Rates[0].Rate != 4.5 {
+		t.Errorf("Rates = %+v, want one thirtyYearFixed rate of 4.5", result.Rates)
+	}
+}
+
+func TestGetMonthlyPayments(t *testing.T) {
+	var got url.Values
+	srv := newEchoServer(t, "/GetMonthlyPayments.htm", `<paymentsResults>
+  <message><code>0</code></message>
+  <response>
+    <payment>
+      <monthlyPrincipalAndInterest>900</monthlyPrincipalAndInterest>
+      <totalMonthlyPayment>1200</totalMonthlyPayment>
+    </payment>
+    <downPayment>20000</downPayment>
+  </response>
+</paymentsResults>`, &got)
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Mortgage.GetMonthlyPayments(MonthlyPaymentsRequest{Price: 200000, Down: 20000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Get(priceParam) != "200000" || got.Get(downParam) != "20000" {
+		t.Errorf("query = %v, want price/down set", got)
+	}
+	if result.Payment.TotalMonthlyPayment != 1200 {
+		t.Errorf("TotalMonthlyPayment = %d, want 1200", result.Payment.TotalMonthlyPayment)
+	}
+}
+
+func TestCalculateMonthlyPaymentsAdvanced(t *testing.T) {
+	var got url.Values
+	srv := newEchoServer(t, "/CalculateMonthlyPaymentsAdvanced.htm", `<paymentsResults>
+  <message><code>0</code></message>
+  <response>
+    <payment loanType="thirtyYearFixed"><totalMonthlyPayment>1200</totalMonthlyPayment></payment>
+    <payment loanType="fifteenYearFixed"><totalMonthlyPayment>1500</totalMonthlyPayment></payment>
+  </response>
+</paymentsResults>`, &got)
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Mortgage.CalculateMonthlyPaymentsAdvanced(AdvancedMonthlyPaymentsRequest{
+		MonthlyPaymentsRequest: MonthlyPaymentsRequest{Price: 200000, Down: 20000},
+		Terms:                  []string{"30", "15"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[termsParam] == nil || len(got[termsParam]) != 2 {
+		t.Errorf("terms param = %v, want 2 values", got[termsParam])
+	}
+	if len(result.Payments) != 2 {
+		t.Errorf("Payments = %+v, want 2 entries", result.Payments)
+	}
+}
+
+func TestCalculateAffordability(t *testing.T) {
+	var got url.Values
+	srv := newEchoServer(t, "/CalculateAffordability.htm", `<affordabilityResults>
+  <message><code>0</code></message>
+  <response>
+    <housePrice>250000</housePrice>
+    <payment loanType="thirtyYearFixed"><totalMonthlyPayment>1300</totalMonthlyPayment></payment>
+  </response>
+</affordabilityResults>`, &got)
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Mortgage.CalculateAffordability(AffordabilityRequest{Income: 80000, DebtPerMonth: 500, DownPayment: 20000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Get(incomeParam) != "80000" || got.Get(debtParam) != "500" || got.Get(downParam) != "20000" {
+		t.Errorf("query = %v, want income/debt/down set", got)
+	}
+	if result.HousePrice != 250000 {
+		t.Errorf("HousePrice = %d, want 250000", result.HousePrice)
+	}
+}
@@ -0,0 +1,126 @@
+package zillow
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Sentinel errors for the Zillow API codes callers are most likely to want
+// to branch on. Use errors.Is to test for these; APIError.Unwrap exposes
+// them so errors.Is/errors.As see through the concrete *APIError.
+var (
+	// ErrInvalidZWSID is returned when the zws-Id query parameter is
+	// missing or not recognized (API code 3).
+	ErrInvalidZWSID = errors.New("zillow: invalid or missing ZWSID")
+
+	// ErrZPIDNotFound is returned when the requested property, or the
+	// address used to look one up, could not be resolved (API codes 4, 5, 6).
+	ErrZPIDNotFound = errors.New("zillow: zpid or address not found")
+
+	// ErrRateLimited is returned when the account has exhausted its daily
+	// call allowance (API code 7).
+	ErrRateLimited = errors.New("zillow: daily rate limit exceeded")
+
+	// ErrServiceUnavailable is returned when Zillow's API is down for
+	// maintenance (API code 2).
+	ErrServiceUnavailable = errors.New("zillow: service unavailable")
+
+	// ErrNoSearchResults is returned by SearchResults.Unique when the
+	// search matched no properties.
+	ErrNoSearchResults = errors.New("zillow: no search results returned")
+
+	// ErrAmbiguousAddress is returned by SearchResults.Unique when the
+	// search matched more than one property.
+	ErrAmbiguousAddress = errors.New("zillow: multiple search results returned; address is ambiguous")
+)
+
+// codeErrors maps documented Zillow <message><code> values to the sentinel
+// they represent. Codes not present here still produce an *APIError, just
+// without a matching sentinel to unwrap to.
+var codeErrors = map[int]error{
+	2: ErrServiceUnavailable,
+	3: ErrInvalidZWSID,
+	4: ErrZPIDNotFound,
+	5: ErrZPIDNotFound,
+	6: ErrZPIDNotFound,
+	7: ErrRateLimited,
+}
+
+// APIError is returned when Zillow's response carries a non-zero
+// <message><code>. Code and Text come straight from that message; HTTPStatus
+// is the status of the HTTP response that carried it (usually 200, since
+// Zillow reports API-level failures inside a 200 body).
+type APIError struct {
+	Code       int
+	Text       string
+	HTTPStatus int
+
+	sentinel error
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("zillow: api error %d: %s", e.Code, e.Text)
+}
+
+// Unwrap lets errors.Is/errors.As match an APIError against the sentinel
+// error for its Code, e.g. errors.Is(err, zillow.ErrRateLimited).
+func (e *APIError) Unwrap() error {
+	return e.sentinel
+}
+
+// RateLimitWarning is returned alongside a successful result when Zillow
+// sets <message><limit-warning> on an otherwise successful response. Unlike
+// APIError, the call still returned usable data; callers that want to back
+// off before hitting ErrRateLimited can check for it with errors.As.
+type RateLimitWarning struct{}
+
+func (e *RateLimitWarning) Error() string {
+	return "zillow: approaching daily rate limit"
+}
+
+// checkMessage inspects a decoded Message and returns the error it
+// represents, if any: an *APIError for a non-zero code, a *RateLimitWarning
+// if only the limit-warning flag is set, or nil if the call succeeded
+// cleanly.
+func checkMessage(msg Message, httpStatus int) error {
+	if msg.Code != 0 {
+		return &APIError{
+			Code:       msg.Code,
+			Text:       msg.Text,
+			HTTPStatus: httpStatus,
+			sentinel:   codeErrors[msg.Code],
+		}
+	}
+	if msg.LimitWarning {
+		return &RateLimitWarning{}
+	}
+	return nil
+}
+
+// messageOf extracts the Message field that every Zillow response struct
+// embeds, via reflection, so a single check in Client.get covers every
+// endpoint without each result type implementing an interface.
+func messageOf(result interface{}) (Message, bool) {
+	v := reflect.ValueOf(result)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return Message{}, false
+	}
+	f := v.FieldByName("Message")
+	if !f.IsValid() {
+		return Message{}, false
+	}
+	msg, ok := f.Interface().(Message)
+	return msg, ok
+}
+
+// isRateLimitWarning reports whether err is (or wraps) a *RateLimitWarning,
+// the one error Client.get returns alongside a populated result rather than
+// in place of one.
+func isRateLimitWarning(err error) bool {
+	var rw *RateLimitWarning
+	return errors.As(err, &rw)
+}
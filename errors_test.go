@@ -0,0 +1,100 @@
+package zillow
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckMessage(t *testing.T) {
+	cases := []struct {
+		name     string
+		msg      Message
+		wantNil  bool
+		wantIs   error
+		wantWarn bool
+		wantCode int
+	}{
+		{
+			name:    "success",
+			msg:     Message{Code: 0},
+			wantNil: true,
+		},
+		{
+			name:     "limit warning on success",
+			msg:      Message{Code: 0, LimitWarning: true},
+			wantWarn: true,
+		},
+		{
+			name:     "service unavailable",
+			msg:      Message{Code: 2, Text: "Error: service currently unavailable"},
+			wantIs:   ErrServiceUnavailable,
+			wantCode: 2,
+		},
+		{
+			name:     "invalid zwsid",
+			msg:      Message{Code: 3, Text: "Error: invalid or missing ZWS-ID"},
+			wantIs:   ErrInvalidZWSID,
+			wantCode: 3,
+		},
+		{
+			name:     "zpid not found (unable to resolve address)",
+			msg:      Message{Code: 4},
+			wantIs:   ErrZPIDNotFound,
+			wantCode: 4,
+		},
+		{
+			name:     "zpid not found (no exact match)",
+			msg:      Message{Code: 5},
+			wantIs:   ErrZPIDNotFound,
+			wantCode: 5,
+		},
+		{
+			name:     "zpid not found (zpid does not exist)",
+			msg:      Message{Code: 6},
+			wantIs:   ErrZPIDNotFound,
+			wantCode: 6,
+		},
+		{
+			name:     "rate limited",
+			msg:      Message{Code: 7},
+			wantIs:   ErrRateLimited,
+			wantCode: 7,
+		},
+		{
+			name:     "unmapped code still produces an APIError",
+			msg:      Message{Code: 99, Text: "Error: something else"},
+			wantCode: 99,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkMessage(c.msg, 200)
+
+			if c.wantNil {
+				if err != nil {
+					t.Fatalf("got %v, want nil", err)
+				}
+				return
+			}
+
+			if c.wantWarn {
+				if !isRateLimitWarning(err) {
+					t.Fatalf("got %v, want a *RateLimitWarning", err)
+				}
+				return
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got %v, want an *APIError", err)
+			}
+			if apiErr.Code != c.wantCode {
+				t.Errorf("Code = %d, want %d", apiErr.Code, c.wantCode)
+			}
+			if c.wantIs != nil && !errors.Is(err, c.wantIs) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", err, c.wantIs)
+			}
+		})
+	}
+}
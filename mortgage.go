@@ -0,0 +1,260 @@
+package zillow
+
+import (
+	"context"
+	"encoding/xml"
+	"net/url"
+	"strconv"
+)
+
+// MortgageService groups the Mortgage Rates and Mortgage Calculators API.
+type MortgageService struct {
+	client *Client
+}
+
+const (
+	getRateSummary                   = "GetRateSummary"
+	getMonthlyPayments               = "GetMonthlyPayments"
+	calculateMonthlyPaymentsAdvanced = "CalculateMonthlyPaymentsAdvanced"
+	calculateAffordability           = "CalculateAffordability"
+)
+
+const (
+	priceParam    = "price"
+	downParam     = "down"
+	amountParam   = "amount"
+	rateParam     = "rate"
+	scheduleParam = "schedule"
+	incomeParam   = "income"
+	debtParam     = "debt"
+	termsParam    = "terms"
+)
+
+// Rate is a single loan program's current average rate, as returned by
+// GetRateSummary.
+type Rate struct {
+	LoanType string  `xml:"loanType,attr"`
+	Rate     float64 `xml:",chardata"`
+}
+
+// RateSummaryRequest identifies the state to fetch average rates for; an
+// empty State requests the national average.
+type RateSummaryRequest struct {
+	State string
+}
+
+// RateSummaryResult is the response to GetRateSummary.
+type RateSummaryResult struct {
+	XMLName xml.Name `xml:"rateSummary"`
+
+	Message Message `xml:"message"`
+
+	Rates []Rate `xml:"response>today>rates>rate"`
+}
+
+// GetRateSummaryCtx is the context-aware form of GetRateSummary.
+func (m *MortgageService) GetRateSummaryCtx(ctx context.Context, request RateSummaryRequest) (*RateSummaryResult, error) {
+	values := url.Values{
+		zwsIdParam: {m.client.zwsID()},
+	}
+	if request.State != "" {
+		values.Set(stateParam, request.State)
+	}
+
+	var result RateSummaryResult
+	err := m.client.get(ctx, getRateSummary, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetRateSummary fetches today's average mortgage rates by loan type,
+// optionally scoped to a state.
+func (m *MortgageService) GetRateSummary(request RateSummaryRequest) (*RateSummaryResult, error) {
+	return m.GetRateSummaryCtx(context.Background(), request)
+}
+
+// MonthlyPaymentsRequest describes a loan to estimate monthly payments for.
+type MonthlyPaymentsRequest struct {
+	Price    int
+	Down     int
+	Zip      string
+	Amount   int
+	Rate     float64
+	Schedule string
+}
+
+// PaymentBreakdown is the monthly cost breakdown common to
+// GetMonthlyPayments, CalculateMonthlyPaymentsAdvanced, and
+// CalculateAffordability.
+type PaymentBreakdown struct {
+	LoanType                    string `xml:"loanType,attr"`
+	MonthlyPrincipalAndInterest int    `xml:"monthlyPrincipalAndInterest"`
+	MonthlyPropertyTaxes        int    `xml:"monthlyPropertyTaxes"`
+	MonthlyHazardInsurance      int    `xml:"monthlyHazardInsurance"`
+	MonthlyPMI                  int    `xml:"monthlyPMI"`
+	MonthlyHOADues              int    `xml:"monthlyHOADues"`
+	TotalMonthlyPayment         int    `xml:"totalMonthlyPayment"`
+	DownPayment                 int    `xml:"downPayment"`
+	LoanAmount                  int    `xml:"loanAmount"`
+}
+
+// MonthlyPaymentsResult is the response to GetMonthlyPayments.
+type MonthlyPaymentsResult struct {
+	XMLName xml.Name `xml:"paymentsResults"`
+
+	Message Message `xml:"message"`
+
+	Payment     PaymentBreakdown `xml:"response>payment"`
+	DownPayment int              `xml:"response>downPayment"`
+	Zip         string           `xml:"response>zip"`
+}
+
+// GetMonthlyPaymentsCtx is the context-aware form of GetMonthlyPayments.
+func (m *MortgageService) GetMonthlyPaymentsCtx(ctx context.Context, request MonthlyPaymentsRequest) (*MonthlyPaymentsResult, error) {
+	values := url.Values{
+		zwsIdParam: {m.client.zwsID()},
+		priceParam: {strconv.Itoa(request.Price)},
+		downParam:  {strconv.Itoa(request.Down)},
+	}
+	if request.Zip != "" {
+		values.Set(zipParam, request.Zip)
+	}
+	if request.Amount != 0 {
+		values.Set(amountParam, strconv.Itoa(request.Amount))
+	}
+	if request.Rate != 0 {
+		values.Set(rateParam, strconv.FormatFloat(request.Rate, 'f', -1, 64))
+	}
+	if request.Schedule != "" {
+		values.Set(scheduleParam, request.Schedule)
+	}
+
+	var result MonthlyPaymentsResult
+	err := m.client.get(ctx, getMonthlyPayments, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetMonthlyPayments estimates the monthly payment breakdown (principal,
+// interest, taxes, insurance, PMI) for a given price and down payment.
+func (m *MortgageService) GetMonthlyPayments(request MonthlyPaymentsRequest) (*MonthlyPaymentsResult, error) {
+	return m.GetMonthlyPaymentsCtx(context.Background(), request)
+}
+
+// AdvancedMonthlyPaymentsRequest adds loan program and term selection on
+// top of MonthlyPaymentsRequest for CalculateMonthlyPaymentsAdvanced.
+type AdvancedMonthlyPaymentsRequest struct {
+	MonthlyPaymentsRequest
+
+	Terms []string
+}
+
+// AdvancedMonthlyPaymentsResult is the response to
+// CalculateMonthlyPaymentsAdvanced.
+type AdvancedMonthlyPaymentsResult struct {
+	XMLName xml.Name `xml:"paymentsResults"`
+
+	Message Message `xml:"message"`
+
+	Payments []PaymentBreakdown `xml:"response>payment"`
+}
+
+// CalculateMonthlyPaymentsAdvancedCtx is the context-aware form of
+// CalculateMonthlyPaymentsAdvanced.
+func (m *MortgageService) CalculateMonthlyPaymentsAdvancedCtx(ctx context.Context, request AdvancedMonthlyPaymentsRequest) (*AdvancedMonthlyPaymentsResult, error) {
+	values := url.Values{
+		zwsIdParam: {m.client.zwsID()},
+		priceParam: {strconv.Itoa(request.Price)},
+		downParam:  {strconv.Itoa(request.Down)},
+	}
+	if request.Zip != "" {
+		values.Set(zipParam, request.Zip)
+	}
+	if request.Amount != 0 {
+		values.Set(amountParam, strconv.Itoa(request.Amount))
+	}
+	if request.Rate != 0 {
+		values.Set(rateParam, strconv.FormatFloat(request.Rate, 'f', -1, 64))
+	}
+	if request.Schedule != "" {
+		values.Set(scheduleParam, request.Schedule)
+	}
+	if len(request.Terms) > 0 {
+		values[termsParam] = request.Terms
+	}
+
+	var result AdvancedMonthlyPaymentsResult
+	err := m.client.get(ctx, calculateMonthlyPaymentsAdvanced, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// CalculateMonthlyPaymentsAdvanced estimates monthly payments across one or
+// more loan programs (e.g. 30-year fixed, 15-year fixed, 5/1 ARM) at once.
+func (m *MortgageService) CalculateMonthlyPaymentsAdvanced(request AdvancedMonthlyPaymentsRequest) (*AdvancedMonthlyPaymentsResult, error) {
+	return m.CalculateMonthlyPaymentsAdvancedCtx(context.Background(), request)
+}
+
+// AffordabilityRequest describes a household's finances for
+// CalculateAffordability.
+type AffordabilityRequest struct {
+	Income       int
+	DebtPerMonth int
+	DownPayment  int
+	Zip          string
+	Schedule     string
+	Rate         float64
+	Terms        []string
+}
+
+// AffordabilityResult is the response to CalculateAffordability.
+type AffordabilityResult struct {
+	XMLName xml.Name `xml:"affordabilityResults"`
+
+	Message Message `xml:"message"`
+
+	HousePrice int                `xml:"response>housePrice"`
+	Payments   []PaymentBreakdown `xml:"response>payment"`
+}
+
+// CalculateAffordabilityCtx is the context-aware form of
+// CalculateAffordability.
+func (m *MortgageService) CalculateAffordabilityCtx(ctx context.Context, request AffordabilityRequest) (*AffordabilityResult, error) {
+	values := url.Values{
+		zwsIdParam:  {m.client.zwsID()},
+		incomeParam: {strconv.Itoa(request.Income)},
+		debtParam:   {strconv.Itoa(request.DebtPerMonth)},
+		downParam:   {strconv.Itoa(request.DownPayment)},
+	}
+	if request.Zip != "" {
+		values.Set(zipParam, request.Zip)
+	}
+	if request.Schedule != "" {
+		values.Set(scheduleParam, request.Schedule)
+	}
+	if request.Rate != 0 {
+		values.Set(rateParam, strconv.FormatFloat(request.Rate, 'f', -1, 64))
+	}
+	if len(request.Terms) > 0 {
+		values[termsParam] = request.Terms
+	}
+
+	var result AffordabilityResult
+	err := m.client.get(ctx, calculateAffordability, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// CalculateAffordability estimates the house price a household can afford
+// given income, debts, and a down payment.
+func (m *MortgageService) CalculateAffordability(request AffordabilityRequest) (*AffordabilityResult, error) {
+	return m.CalculateAffordabilityCtx(context.Background(), request)
+}
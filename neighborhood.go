@@ -0,0 +1,167 @@
+package zillow
+
+import (
+	"context"
+	"encoding/xml"
+	"net/url"
+	"strconv"
+)
+
+// NeighborhoodService groups the Neighborhood Data API: region hierarchy
+// lookups and region Zindex charts.
+type NeighborhoodService struct {
+	client *Client
+}
+
+const (
+	getRegionChildren = "GetRegionChildren"
+	getRegionChart    = "GetRegionChart"
+)
+
+const (
+	regionIdParam     = "regionId"
+	stateParam        = "state"
+	countyParam       = "county"
+	cityParam         = "city"
+	childtypeParam    = "childtype"
+	neighborhoodParam = "neighborhood"
+	zipParam          = "zip"
+)
+
+// RegionChildrenRequest identifies the region to list children for, either
+// by RegionID or by a state/county/city combination.
+type RegionChildrenRequest struct {
+	RegionID  string
+	State     string
+	County    string
+	City      string
+	ChildType string
+}
+
+// RegionChild is one entry in a GetRegionChildren response.
+type RegionChild struct {
+	ID   string `xml:"id"`
+	Name string `xml:"name"`
+	Type string `xml:"type"`
+
+	Latitude  float64 `xml:"latitude"`
+	Longitude float64 `xml:"longitude"`
+
+	ZIndex              string  `xml:"zindex"`
+	ZIndexOneYearChange float64 `xml:"zindexOneYearChange"`
+
+	Url string `xml:"url"`
+}
+
+// RegionChildrenResult is the response to GetRegionChildren.
+type RegionChildrenResult struct {
+	XMLName xml.Name `xml:"regionchildren"`
+
+	Message Message `xml:"message"`
+
+	Region   RegionChild   `xml:"response>region"`
+	Children []RegionChild `xml:"response>list>region"`
+}
+
+// GetRegionChildrenCtx is the context-aware form of GetRegionChildren.
+func (n *NeighborhoodService) GetRegionChildrenCtx(ctx context.Context, request RegionChildrenRequest) (*RegionChildrenResult, error) {
+	values := url.Values{
+		zwsIdParam: {n.client.zwsID()},
+	}
+	if request.RegionID != "" {
+		values.Set(regionIdParam, request.RegionID)
+	}
+	if request.State != "" {
+		values.Set(stateParam, request.State)
+	}
+	if request.County != "" {
+		values.Set(countyParam, request.County)
+	}
+	if request.City != "" {
+		values.Set(cityParam, request.City)
+	}
+	if request.ChildType != "" {
+		values.Set(childtypeParam, request.ChildType)
+	}
+
+	var result RegionChildrenResult
+	err := n.client.get(ctx, getRegionChildren, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetRegionChildren lists the sub-regions (e.g. neighborhoods within a city)
+// of the region identified by request.
+func (n *NeighborhoodService) GetRegionChildren(request RegionChildrenRequest) (*RegionChildrenResult, error) {
+	return n.GetRegionChildrenCtx(context.Background(), request)
+}
+
+// RegionChartRequest identifies a region and chart rendering parameters for
+// GetRegionChart. Exactly one of City/State, County/State, State, or Zip
+// should be set to identify the region.
+type RegionChartRequest struct {
+	City   string
+	State  string
+	County string
+	Zip    string
+
+	UnitType string
+	Width    int
+	Height   int
+	Duration string
+}
+
+// RegionChartResult is the response to GetRegionChart.
+type RegionChartResult struct {
+	XMLName xml.Name `xml:"regionchart"`
+
+	Message Message `xml:"message"`
+
+	Url    string `xml:"response>url"`
+	ZIndex string `xml:"response>zindex"`
+}
+
+// GetRegionChartCtx is the context-aware form of GetRegionChart.
+func (n *NeighborhoodService) GetRegionChartCtx(ctx context.Context, request RegionChartRequest) (*RegionChartResult, error) {
+	values := url.Values{
+		zwsIdParam: {n.client.zwsID()},
+	}
+	if request.City != "" {
+		values.Set(cityParam, request.City)
+	}
+	if request.State != "" {
+		values.Set(stateParam, request.State)
+	}
+	if request.County != "" {
+		values.Set(countyParam, request.County)
+	}
+	if request.Zip != "" {
+		values.Set(zipParam, request.Zip)
+	}
+	if request.UnitType != "" {
+		values.Set(unitTypeParam, request.UnitType)
+	}
+	if request.Width != 0 {
+		values.Set(widthParam, strconv.Itoa(request.Width))
+	}
+	if request.Height != 0 {
+		values.Set(heightParam, strconv.Itoa(request.Height))
+	}
+	if request.Duration != "" {
+		values.Set(chartDurationParam, request.Duration)
+	}
+
+	var result RegionChartResult
+	err := n.client.get(ctx, getRegionChart, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetRegionChart renders a Zindex history chart image URL for a region.
+func (n *NeighborhoodService) GetRegionChart(request RegionChartRequest) (*RegionChartResult, error) {
+	return n.GetRegionChartCtx(context.Background(), request)
+}
@@ -0,0 +1,206 @@
+package zillow
+
+import (
+	"context"
+	"encoding/xml"
+	"net/url"
+	"strconv"
+)
+
+// PropertyService groups the Property Details API: deep comps, deep search,
+// and updated (edited) property facts.
+type PropertyService struct {
+	client *Client
+}
+
+const (
+	getDeepComps              = "GetDeepComps"
+	getDeepSearchResults      = "GetDeepSearchResults"
+	getUpdatedPropertyDetails = "GetUpdatedPropertyDetails"
+)
+
+// EditedFacts holds the subset of property facts Zillow lets homeowners
+// correct, as returned by GetUpdatedPropertyDetails and the deep variants of
+// GetComps/GetSearchResults.
+type EditedFacts struct {
+	Use            string  `xml:"use"`
+	Beds           int     `xml:"bedrooms"`
+	Baths          float64 `xml:"bathrooms"`
+	FinishedSqFt   int     `xml:"finishedSqFt"`
+	LotSizeSqFt    int     `xml:"lotSizeSqFt"`
+	YearBuilt      int     `xml:"yearBuilt"`
+	NumFloors      int     `xml:"numFloors"`
+	Basement       string  `xml:"basement"`
+	Roof           string  `xml:"roof"`
+	View           string  `xml:"view"`
+	ParkingType    string  `xml:"parkingType"`
+	HeatingSources string  `xml:"heatingSources"`
+	HeatingSystem  string  `xml:"heatingSystem"`
+	Rooms          string  `xml:"rooms"`
+}
+
+// PriceHistoryEvent is a single row of a property's sale/listing history.
+type PriceHistoryEvent struct {
+	Date         string `xml:"date,attr"`
+	Event        string `xml:"event,attr"`
+	Price        int    `xml:"price,attr"`
+	PricePerSqFt int    `xml:"priceSqFt,attr"`
+	Source       string `xml:"source,attr"`
+}
+
+// TaxAssessment is a single year of a property's assessed value and taxes.
+type TaxAssessment struct {
+	Year    int `xml:"year,attr"`
+	Value   int `xml:"value,attr"`
+	TaxPaid int `xml:"taxIncrease,attr"`
+}
+
+// School is a nearby school listed on a property's page.
+type School struct {
+	Name     string  `xml:"name"`
+	Rating   int     `xml:"rating"`
+	Level    string  `xml:"level"`
+	Distance float64 `xml:"distance"`
+}
+
+// UpdatedPropertyDetailsRequest identifies the property to fetch edited
+// facts for.
+type UpdatedPropertyDetailsRequest struct {
+	Zpid string `xml:"zpid"`
+}
+
+// UpdatedPropertyDetailsResult is the response to GetUpdatedPropertyDetails.
+type UpdatedPropertyDetailsResult struct {
+	XMLName xml.Name `xml:"updatedPropertyDetails"`
+
+	Request UpdatedPropertyDetailsRequest `xml:"request"`
+	Message Message                       `xml:"message"`
+
+	Links        Links               `xml:"response>links"`
+	Address      Address             `xml:"response>address"`
+	Images       []string            `xml:"response>images>image"`
+	EditedFacts  EditedFacts         `xml:"response>editedFacts"`
+	PriceHistory []PriceHistoryEvent `xml:"response>priceHistory>record"`
+	TaxHistory   []TaxAssessment     `xml:"response>taxHistory>taxAssessment"`
+	Schools      []School            `xml:"response>schools>school"`
+}
+
+// GetUpdatedPropertyDetailsCtx is the context-aware form of
+// GetUpdatedPropertyDetails.
+func (p *PropertyService) GetUpdatedPropertyDetailsCtx(ctx context.Context, request UpdatedPropertyDetailsRequest) (*UpdatedPropertyDetailsResult, error) {
+	values := url.Values{
+		zwsIdParam: {p.client.zwsID()},
+		zpidParam:  {request.Zpid},
+	}
+	var result UpdatedPropertyDetailsResult
+	err := p.client.get(ctx, getUpdatedPropertyDetails, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetUpdatedPropertyDetails fetches the homeowner-edited facts, price
+// history, tax history, and nearby schools for a property.
+func (p *PropertyService) GetUpdatedPropertyDetails(request UpdatedPropertyDetailsRequest) (*UpdatedPropertyDetailsResult, error) {
+	return p.GetUpdatedPropertyDetailsCtx(context.Background(), request)
+}
+
+// DeepSearchResult is a SearchResult enriched with edited facts, as returned
+// by GetDeepSearchResults.
+type DeepSearchResult struct {
+	XMLName xml.Name `xml:"result"`
+
+	Zpid string `xml:"zpid"`
+
+	Links           Links       `xml:"links"`
+	Address         Address     `xml:"address"`
+	Zestimate       Zestimate   `xml:"zestimate"`
+	LocalRealEstate []Region    `xml:"localRealEstate>region"`
+	EditedFacts     EditedFacts `xml:"editedFacts"`
+}
+
+// DeepSearchResults is the response to GetDeepSearchResults.
+type DeepSearchResults struct {
+	XMLName xml.Name `xml:"searchresults"`
+
+	Request SearchRequest `xml:"request"`
+	Message Message       `xml:"message"`
+
+	Results []DeepSearchResult `xml:"response>results>result"`
+}
+
+// GetDeepSearchResultsCtx is the context-aware form of GetDeepSearchResults.
+func (p *PropertyService) GetDeepSearchResultsCtx(ctx context.Context, request SearchRequest) (*DeepSearchResults, error) {
+	values := url.Values{
+		zwsIdParam:         {p.client.zwsID()},
+		addressParam:       {request.Address},
+		cityStateZipParam:  {request.CityStateZip},
+		rentzestimateParam: {strconv.FormatBool(request.Rentzestimate)},
+	}
+	var result DeepSearchResults
+	err := p.client.get(ctx, getDeepSearchResults, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetDeepSearchResults is GetSearchResults with editedFacts included inline,
+// saving a follow-up GetUpdatedPropertyDetails call per result.
+func (p *PropertyService) GetDeepSearchResults(request SearchRequest) (*DeepSearchResults, error) {
+	return p.GetDeepSearchResultsCtx(context.Background(), request)
+}
+
+// DeepComp is a Comp enriched with edited facts, as returned by GetDeepComps.
+type DeepComp struct {
+	Score       float64     `xml:"score,attr"`
+	Zpid        string      `xml:"zpid"`
+	Links       Links       `xml:"links"`
+	Address     Address     `xml:"address"`
+	Zestimate   Zestimate   `xml:"zestimate"`
+	EditedFacts EditedFacts `xml:"editedFacts"`
+}
+
+// DeepPrincipal is a Principal enriched with edited facts, as returned by
+// GetDeepComps.
+type DeepPrincipal struct {
+	Zpid        string      `xml:"zpid"`
+	Links       Links       `xml:"links"`
+	Address     Address     `xml:"address"`
+	Zestimate   Zestimate   `xml:"zestimate"`
+	EditedFacts EditedFacts `xml:"editedFacts"`
+}
+
+// DeepCompsResult is the response to GetDeepComps.
+type DeepCompsResult struct {
+	XMLName xml.Name `xml:"comps"`
+
+	Request CompsRequest `xml:"request"`
+	Message Message      `xml:"message"`
+
+	Principal   DeepPrincipal `xml:"response>properties>principal"`
+	Comparables []DeepComp    `xml:"response>properties>comparables>comp"`
+}
+
+// GetDeepCompsCtx is the context-aware form of GetDeepComps.
+func (p *PropertyService) GetDeepCompsCtx(ctx context.Context, request CompsRequest) (*DeepCompsResult, error) {
+	values := url.Values{
+		zwsIdParam:         {p.client.zwsID()},
+		zpidParam:          {request.Zpid},
+		countParam:         {strconv.Itoa(request.Count)},
+		rentzestimateParam: {strconv.FormatBool(request.Rentzestimate)},
+	}
+	var result DeepCompsResult
+	err := p.client.get(ctx, getDeepComps, values, &result)
+	if err != nil && !isRateLimitWarning(err) {
+		return nil, err
+	}
+	return &result, err
+}
+
+// GetDeepComps is GetComps with editedFacts included inline for the
+// principal and every comparable.
+func (p *PropertyService) GetDeepComps(request CompsRequest) (*DeepCompsResult, error) {
+	return p.GetDeepCompsCtx(context.Background(), request)
+}
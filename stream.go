@@ -0,0 +1,294 @@
+package zillow
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// maxCompsPerRequest is the largest Count GetComps accepts in one call;
+// CompsIterator splits a larger request into this many comps per call.
+const maxCompsPerRequest = 25
+
+// StreamService groups iterators over endpoints that return bounded result
+// sets (GetComps, GetSearchResults), adding pagination and polling on top.
+// It remembers which Zpids it has already surfaced, so a StreamDiscardInitial
+// iterator created later against the same StreamService only yields deltas.
+type StreamService struct {
+	client *Client
+
+	mu         sync.Mutex
+	seenComps  map[string]map[string]bool // principal zpid -> comp zpids already surfaced
+	seenSearch map[string]map[string]bool // seed-set key -> zpids already surfaced for that seed set
+}
+
+// streamConfig is built from the StreamOpt values passed to Stream.Comps or
+// Stream.Search.
+type streamConfig struct {
+	discardInitial bool
+}
+
+// StreamOpt configures a CompsIterator or SearchIterator.
+type StreamOpt func(*streamConfig)
+
+// StreamDiscardInitial marks every Zpid observed by the very first poll as
+// already-seen without surfacing it, so that iterator (and any later one
+// against the same StreamService) only yields comps/results that are new
+// since that first poll.
+func StreamDiscardInitial() StreamOpt {
+	return func(c *streamConfig) {
+		c.discardInitial = true
+	}
+}
+
+// CompsIterator walks the comparables for a property, fetching
+// request.Count comps maxCompsPerRequest at a time and skipping any Zpid
+// already surfaced by a previous call against the same StreamService.
+type CompsIterator struct {
+	ctx     context.Context
+	stream  *StreamService
+	request CompsRequest
+	cfg     streamConfig
+
+	fetched  int
+	queue    []Comp
+	cur      Comp
+	err      error
+	done     bool
+	firstRun bool
+}
+
+// Comps returns an iterator over request.Zpid's comparables.
+func (s *StreamService) Comps(ctx context.Context, request CompsRequest, opts ...StreamOpt) *CompsIterator {
+	var cfg streamConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	s.mu.Lock()
+	_, seen := s.seenComps[request.Zpid]
+	if !seen {
+		s.seenComps[request.Zpid] = map[string]bool{}
+	}
+	s.mu.Unlock()
+
+	return &CompsIterator{
+		ctx:      ctx,
+		stream:   s,
+		request:  request,
+		cfg:      cfg,
+		firstRun: !seen,
+	}
+}
+
+// Next advances the iterator, fetching another page from Zillow if the
+// current one is exhausted. It returns false when request.Count comps have
+// been walked, the context is cancelled, or a request fails; check Err to
+// tell cancellation/failure apart from ordinary exhaustion.
+func (i *CompsIterator) Next() bool {
+	if i.done {
+		return false
+	}
+	if err := i.ctx.Err(); err != nil {
+		i.err = err
+		i.done = true
+		return false
+	}
+
+	for len(i.queue) == 0 {
+		if i.fetched >= i.request.Count {
+			i.done = true
+			return false
+		}
+		if err := i.fetchPage(); err != nil {
+			i.err = err
+			i.done = true
+			return false
+		}
+	}
+
+	i.cur = i.queue[0]
+	i.queue = i.queue[1:]
+	return true
+}
+
+func (i *CompsIterator) fetchPage() error {
+	pageCount := i.request.Count - i.fetched
+	if pageCount > maxCompsPerRequest {
+		pageCount = maxCompsPerRequest
+	}
+
+	result, err := i.stream.client.GetCompsCtx(i.ctx, CompsRequest{
+		Zpid:          i.request.Zpid,
+		Count:         pageCount,
+		Rentzestimate: i.request.Rentzestimate,
+	})
+	if err != nil {
+		return err
+	}
+	i.fetched += pageCount
+
+	i.stream.mu.Lock()
+	seen := i.stream.seenComps[i.request.Zpid]
+	var fresh []Comp
+	for _, comp := range result.Comparables {
+		if seen[comp.Zpid] {
+			continue
+		}
+		seen[comp.Zpid] = true
+		fresh = append(fresh, comp)
+	}
+	i.stream.mu.Unlock()
+
+	if i.cfg.discardInitial && i.firstRun {
+		// Prime the seen set without surfacing this poll's comps.
+		return nil
+	}
+	i.queue = append(i.queue, fresh...)
+	return nil
+}
+
+// Value returns the comp most recently advanced to by Next.
+func (i *CompsIterator) Value() Comp {
+	return i.cur
+}
+
+// Err returns the first error encountered, if any. It should be checked
+// after Next returns false.
+func (i *CompsIterator) Err() error {
+	return i.err
+}
+
+// SearchIterator walks the search results for a list of seed addresses,
+// skipping any Zpid already surfaced by a previous call against the same
+// seed set.
+//
+// GetSearchResults has no endpoint for "other properties in this region", so
+// this does not chase the localRealEstate regions in each result
+// automatically; ExpandedRegions collects the regions of each newly surfaced
+// result so callers can feed their IDs to
+// Client.Neighborhood.GetRegionChildren themselves.
+type SearchIterator struct {
+	ctx    context.Context
+	stream *StreamService
+	seeds  []SearchRequest
+	key    string
+	cfg    streamConfig
+
+	next            int
+	firstRun        bool
+	queue           []SearchResult
+	cur             SearchResult
+	err             error
+	done            bool
+	ExpandedRegions []Region
+}
+
+// seedKey builds a StreamService.seenSearch key that identifies a seed
+// address set regardless of the order its addresses were passed in, so
+// "first poll" is scoped to that set rather than to the StreamService as a
+// whole.
+func seedKey(seeds []SearchRequest) string {
+	parts := make([]string, len(seeds))
+	for i, s := range seeds {
+		parts[i] = s.Address + "|" + s.CityStateZip
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, "||")
+}
+
+// Search returns an iterator over the search results for seedAddresses.
+func (s *StreamService) Search(ctx context.Context, seedAddresses []SearchRequest, opts ...StreamOpt) *SearchIterator {
+	var cfg streamConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	key := seedKey(seedAddresses)
+
+	s.mu.Lock()
+	_, seen := s.seenSearch[key]
+	if !seen {
+		s.seenSearch[key] = map[string]bool{}
+	}
+	s.mu.Unlock()
+
+	return &SearchIterator{
+		ctx:      ctx,
+		stream:   s,
+		seeds:    seedAddresses,
+		key:      key,
+		cfg:      cfg,
+		firstRun: !seen,
+	}
+}
+
+// Next advances the iterator to the next unseen search result, issuing
+// GetSearchResults for the next seed address as needed. It returns false
+// once every seed has been searched, the context is cancelled, or a
+// request fails; check Err to tell those apart.
+func (i *SearchIterator) Next() bool {
+	if i.done {
+		return false
+	}
+	if err := i.ctx.Err(); err != nil {
+		i.err = err
+		i.done = true
+		return false
+	}
+
+	for len(i.queue) == 0 {
+		if i.next >= len(i.seeds) {
+			i.done = true
+			return false
+		}
+		if err := i.fetchSeed(i.seeds[i.next]); err != nil {
+			i.err = err
+			i.done = true
+			return false
+		}
+		i.next++
+	}
+
+	i.cur = i.queue[0]
+	i.queue = i.queue[1:]
+	return true
+}
+
+func (i *SearchIterator) fetchSeed(request SearchRequest) error {
+	result, err := i.stream.client.GetSearchResultsCtx(i.ctx, request)
+	if err != nil {
+		return err
+	}
+
+	i.stream.mu.Lock()
+	seen := i.stream.seenSearch[i.key]
+	var fresh []SearchResult
+	for _, sr := range result.Results {
+		if seen[sr.Zpid] {
+			continue
+		}
+		seen[sr.Zpid] = true
+		fresh = append(fresh, sr)
+		i.ExpandedRegions = append(i.ExpandedRegions, sr.LocalRealEstate...)
+	}
+	i.stream.mu.Unlock()
+
+	if i.cfg.discardInitial && i.firstRun {
+		return nil
+	}
+	i.queue = append(i.queue, fresh...)
+	return nil
+}
+
+// Value returns the search result most recently advanced to by Next.
+func (i *SearchIterator) Value() SearchResult {
+	return i.cur
+}
+
+// Err returns the first error encountered, if any. It should be checked
+// after Next returns false.
+func (i *SearchIterator) Err() error {
+	return i.err
+}
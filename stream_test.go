@@ -0,0 +1,169 @@
+package zillow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"text/template"
+)
+
+// newFixtureServer renders the testdata fixture at path as a text/template
+// for every request matching pattern, so each call can return different data (simulating
+// new comps/results appearing between polls) while reusing one recorded
+// response shape.
+func newFixtureServer(t *testing.T, path, pattern string, render func(call int32) interface{}) *httptest.Server {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := template.Must(template.New(path).Parse(string(data)))
+
+	var calls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "text/xml")
+		if err := tmpl.Execute(w, render(n)); err != nil {
+			t.Error(err)
+		}
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCompsIteratorStreamsOnlyNewComps(t *testing.T) {
+	srv := newFixtureServer(t, "testdata/comps_response.xml", "/GetComps.htm", func(call int32) interface{} {
+		zpids := []string{"100", "200"}
+		if call > 1 {
+			zpids = []string{"100", "300"} // 300 is new on the second poll
+		}
+		return struct {
+			PrincipalZpid string
+			Zpids         []string
+		}{"1", zpids}
+	})
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	req := CompsRequest{Zpid: "1", Count: 2}
+
+	initial := client.Stream.Comps(ctx, req, StreamDiscardInitial())
+	if initial.Next() {
+		t.Fatalf("discard-initial poll yielded %+v, want nothing", initial.Value())
+	}
+	if err := initial.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	poll := client.Stream.Comps(ctx, req)
+	for poll.Next() {
+		got = append(got, poll.Value().Zpid)
+	}
+	if err := poll.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "300" {
+		t.Fatalf("got %v, want [300]", got)
+	}
+}
+
+func TestSearchIteratorDedupesAcrossSeeds(t *testing.T) {
+	srv := newFixtureServer(t, "testdata/search_response.xml", "/GetSearchResults.htm", func(call int32) interface{} {
+		// Both seed addresses resolve to an overlapping property (zpid 1);
+		// it should only be surfaced once.
+		zpids := []string{"1", "2"}
+		if call > 1 {
+			zpids = []string{"1", "3"}
+		}
+		return struct{ Zpids []string }{zpids}
+	})
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	seeds := []SearchRequest{
+		{Address: "1 Main St", CityStateZip: "Anytown, ST"},
+		{Address: "2 Main St", CityStateZip: "Anytown, ST"},
+	}
+
+	var got []string
+	iter := client.Stream.Search(ctx, seeds)
+	for iter.Next() {
+		got = append(got, iter.Value().Zpid)
+	}
+	if err := iter.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %v, want 3 unique zpids (1, 2, 3)", got)
+	}
+}
+
+// TestSearchIteratorDiscardInitialScopedPerSeedSet guards against
+// StreamDiscardInitial being accidentally scoped to the whole StreamService:
+// an unrelated earlier Stream.Search call must not suppress the first-poll
+// discard for a brand-new seed set.
+func TestSearchIteratorDiscardInitialScopedPerSeedSet(t *testing.T) {
+	srv := newFixtureServer(t, "testdata/search_response.xml", "/GetSearchResults.htm", func(call int32) interface{} {
+		return struct{ Zpids []string }{[]string{"1"}}
+	})
+
+	client, err := NewClient(Credentials{ZWSID: "test"}, WithBaseURL(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	// An earlier, unrelated Stream.Search call populates seenSearch.
+	earlier := client.Stream.Search(ctx, []SearchRequest{{Address: "9 Other St"}})
+	for earlier.Next() {
+	}
+	if err := earlier.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A new seed set with StreamDiscardInitial should still discard its own
+	// first poll, regardless of the call above.
+	seeds := []SearchRequest{{Address: "1 Main St", CityStateZip: "Anytown, ST"}}
+	discarded := client.Stream.Search(ctx, seeds, StreamDiscardInitial())
+	if discarded.Next() {
+		t.Fatalf("discard-initial poll yielded %+v, want nothing", discarded.Value())
+	}
+	if err := discarded.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCompsIteratorHonorsContextCancellation(t *testing.T) {
+	client, err := NewClient(Credentials{ZWSID: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	iter := client.Stream.Comps(ctx, CompsRequest{Zpid: "1", Count: 2})
+	if iter.Next() {
+		t.Fatal("Next() = true on a cancelled context, want false")
+	}
+	if iter.Err() == nil {
+		t.Fatal("Err() = nil, want context.Canceled")
+	}
+}
@@ -0,0 +1,60 @@
+package zillow
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// UnmarshalXML tolerates the shapes Zillow actually sends for a currency
+// amount: a populated element like <amount currency="USD">200000</amount>,
+// an empty or self-closed one like <amount/>, and also whitespace-only or
+// non-numeric chardata. encoding/xml's default int decoding already maps an
+// empty element to zero, but fails the whole response with a ParseInt error
+// on whitespace-only or non-numeric chardata; this treats those as zero too.
+func (v *Value) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var aux struct {
+		Currency string `xml:"currency,attr"`
+		Value    string `xml:",chardata"`
+	}
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+
+	v.Currency = aux.Currency
+	v.Value = parseIntTolerant(aux.Value)
+	return nil
+}
+
+// UnmarshalXML mirrors Value's tolerance for missing chardata, and leaves
+// Duration nil rather than 0 when the duration attribute is absent.
+func (vc *ValueChange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var aux struct {
+		Duration *int   `xml:"duration,attr"`
+		Currency string `xml:"currency,attr"`
+		Value    string `xml:",chardata"`
+	}
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+
+	vc.Duration = aux.Duration
+	vc.Currency = aux.Currency
+	vc.Value = parseIntTolerant(aux.Value)
+	return nil
+}
+
+// parseIntTolerant parses s as an int, returning 0 for empty or malformed
+// input instead of an error. Zillow's XML has no schema validation on its
+// end, so chardata that's supposed to be numeric occasionally isn't.
+func parseIntTolerant(s string) int {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,127 @@
+package zillow
+
+import (
+	"encoding/xml"
+	"os"
+	"testing"
+)
+
+func TestValueUnmarshalXML(t *testing.T) {
+	cases := []struct {
+		name         string
+		src          string
+		wantCurrency string
+		wantValue    int
+	}{
+		{
+			name:         "well-formed",
+			src:          `<amount currency="USD">200000</amount>`,
+			wantCurrency: "USD",
+			wantValue:    200000,
+		},
+		{
+			name:         "empty element",
+			src:          `<amount currency="USD"></amount>`,
+			wantCurrency: "USD",
+			wantValue:    0,
+		},
+		{
+			name:         "self-closed",
+			src:          `<amount currency="USD"/>`,
+			wantCurrency: "USD",
+			wantValue:    0,
+		},
+		{
+			name:         "non-numeric chardata",
+			src:          `<amount currency="USD">N/A</amount>`,
+			wantCurrency: "USD",
+			wantValue:    0,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var v Value
+			if err := xml.Unmarshal([]byte(c.src), &v); err != nil {
+				t.Fatalf("Unmarshal(%q): %v", c.src, err)
+			}
+			if v.Currency != c.wantCurrency || v.Value != c.wantValue {
+				t.Errorf("got %+v, want currency=%q value=%d", v, c.wantCurrency, c.wantValue)
+			}
+		})
+	}
+}
+
+func TestValueChangeUnmarshalXML(t *testing.T) {
+	cases := []struct {
+		name         string
+		src          string
+		wantDuration *int
+		wantValue    int
+	}{
+		{
+			name:         "with duration",
+			src:          `<valueChange duration="30" currency="USD">-5000</valueChange>`,
+			wantDuration: intPtr(30),
+			wantValue:    -5000,
+		},
+		{
+			name:         "without duration",
+			src:          `<valueChange currency="USD">-5000</valueChange>`,
+			wantDuration: nil,
+			wantValue:    -5000,
+		},
+		{
+			name:         "empty value without duration",
+			src:          `<valueChange currency="USD"></valueChange>`,
+			wantDuration: nil,
+			wantValue:    0,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var vc ValueChange
+			if err := xml.Unmarshal([]byte(c.src), &vc); err != nil {
+				t.Fatalf("Unmarshal(%q): %v", c.src, err)
+			}
+			if vc.Value != c.wantValue {
+				t.Errorf("got Value=%d, want %d", vc.Value, c.wantValue)
+			}
+			if (c.wantDuration == nil) != (vc.Duration == nil) {
+				t.Fatalf("got Duration=%v, want %v", vc.Duration, c.wantDuration)
+			}
+			if c.wantDuration != nil && *vc.Duration != *c.wantDuration {
+				t.Errorf("got Duration=%d, want %d", *vc.Duration, *c.wantDuration)
+			}
+		})
+	}
+}
+
+// TestZestimateToleratesMissingNodes decodes a fixture with an empty
+// <amount></amount>, no <valuationRange> at all, and a valueChange without
+// a duration attribute, and checks that each comes out as its zero value
+// (nil for Duration).
+func TestZestimateToleratesMissingNodes(t *testing.T) {
+	data, err := os.ReadFile("testdata/zestimate_empty_amount.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var z Zestimate
+	if err := xml.Unmarshal(data, &z); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if z.Amount.Value != 0 {
+		t.Errorf("Amount.Value = %d, want 0", z.Amount.Value)
+	}
+	if z.Low.Value != 0 || z.High.Value != 0 {
+		t.Errorf("Low/High = %+v/%+v, want zero values for absent valuationRange", z.Low, z.High)
+	}
+	if z.ValueChange.Duration != nil {
+		t.Errorf("ValueChange.Duration = %v, want nil", *z.ValueChange.Duration)
+	}
+}
+
+func intPtr(n int) *int { return &n }
@@ -3,39 +3,17 @@ package zillow
 
 import (
 	"encoding/xml"
-	"net/http"
-	"net/url"
-	"strconv"
 )
 
+// Zillow is the Home Valuation subset of the API: Zestimates, search,
+// charts, and comps. It exists for backward compatibility with callers
+// constructed via NewZillow; new code should use Client directly, which
+// also exposes Property, Neighborhood, and Mortgage.
 type Zillow interface {
-	// Home Valuation
 	GetZestimate(ZestimateRequest) (*ZestimateResult, error)
 	GetSearchResults(SearchRequest) (*SearchResults, error)
 	GetChart(ChartRequest) (*ChartResult, error)
 	GetComps(CompsRequest) (*CompsResult, error)
-
-	// Property Details
-	//GetDeepComps()
-	//GetDeepSearchResults()
-	//GetUpdatedPropertyDetails()
-
-	// Neighborhood Data
-	//GetRegionChildren()
-	//GetRegionChart()
-
-	// Mortgage Rates
-	//GetRateSummary()
-
-	// Mortgage Calculators
-	//GetMonthlyPayments()
-	//CalculateMonthlyPaymentsAdvanced()
-	//CalculateAffordability()
-}
-
-// Creates a new zillow client.
-func NewZillow(zwsId string) Zillow {
-	return &zillow{zwsId, baseUrl}
 }
 
 type Message struct {
@@ -53,13 +31,22 @@ type Address struct {
 	Longitude float64 `xml:"longitude"`
 }
 
+// Value is a currency amount. Zillow sometimes returns an empty element
+// (e.g. <amount/>) instead of omitting it, or chardata that isn't a number,
+// so Value decodes via a custom UnmarshalXML that treats missing,
+// whitespace-only, or non-numeric chardata as zero rather than failing the
+// whole response.
 type Value struct {
 	Currency string `xml:"currency,attr"`
 	Value    int    `xml:",chardata"`
 }
 
+// ValueChange is a Value with the duration, in months, it covers. Duration
+// is a pointer because responses for properties without enough history
+// omit the attribute entirely; a zero int would misrepresent that as "no
+// change over zero months" instead of "unknown".
 type ValueChange struct {
-	Duration int    `xml:"duration,attr"`
+	Duration *int   `xml:"duration,attr"`
 	Currency string `xml:"currency,attr"`
 	Value    int    `xml:",chardata"`
 }
@@ -135,6 +122,32 @@ type SearchResults struct {
 	Results []SearchResult `xml:"response>results>result"`
 }
 
+// Best returns the first result, or nil if the search had none. Zillow
+// returns more than one result when an address is ambiguous (e.g. it
+// matches units in a multi-family building); Best picks Zillow's own
+// ranking rather than asserting there is exactly one.
+func (s *SearchResults) Best() *SearchResult {
+	if s == nil || len(s.Results) == 0 {
+		return nil
+	}
+	return &s.Results[0]
+}
+
+// Unique returns the single result, failing with ErrNoSearchResults or
+// ErrAmbiguousAddress if the search didn't return exactly one. Use this
+// instead of Best when an ambiguous address should be treated as an error
+// rather than silently resolved to Zillow's top match.
+func (s *SearchResults) Unique() (*SearchResult, error) {
+	switch len(s.Results) {
+	case 0:
+		return nil, ErrNoSearchResults
+	case 1:
+		return &s.Results[0], nil
+	default:
+		return nil, ErrAmbiguousAddress
+	}
+}
+
 type SearchResult struct {
 	XMLName xml.Name `xml:"result"`
 
@@ -213,80 +226,4 @@ const (
 	getSearchResults = "GetSearchResults"
 	getChart         = "GetChart"
 	getComps         = "GetComps"
-	//TODO other services
 )
-
-type zillow struct {
-	zwsId string
-	url   string
-}
-
-func (z *zillow) get(servicePath string, values url.Values, result interface{}) error {
-	if resp, err := http.Get(z.url + "/" + servicePath + ".htm?" + values.Encode()); err != nil {
-		return err
-	} else if err = xml.NewDecoder(resp.Body).Decode(result); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (z *zillow) GetZestimate(request ZestimateRequest) (*ZestimateResult, error) {
-	values := url.Values{
-		zwsIdParam:         {z.zwsId},
-		zpidParam:          {request.Zpid},
-		rentzestimateParam: {strconv.FormatBool(request.Rentzestimate)},
-	}
-	var result ZestimateResult
-	if err := z.get(getZestimatePath, values, &result); err != nil {
-		return nil, err
-	} else {
-		return &result, nil
-	}
-}
-
-func (z *zillow) GetSearchResults(request SearchRequest) (*SearchResults, error) {
-	values := url.Values{
-		zwsIdParam:         {z.zwsId},
-		addressParam:       {request.Address},
-		cityStateZipParam:  {request.CityStateZip},
-		rentzestimateParam: {strconv.FormatBool(request.Rentzestimate)},
-	}
-	var result SearchResults
-	if err := z.get(getSearchResults, values, &result); err != nil {
-		return nil, err
-	} else {
-		return &result, nil
-	}
-}
-
-func (z *zillow) GetChart(request ChartRequest) (*ChartResult, error) {
-	values := url.Values{
-		zwsIdParam:         {z.zwsId},
-		zpidParam:          {request.Zpid},
-		unitTypeParam:      {request.UnitType},
-		widthParam:         {strconv.Itoa(request.Width)},
-		heightParam:        {strconv.Itoa(request.Height)},
-		chartDurationParam: {request.Duration},
-	}
-	var result ChartResult
-	if err := z.get(getChart, values, &result); err != nil {
-		return nil, err
-	} else {
-		return &result, nil
-	}
-}
-
-func (z *zillow) GetComps(request CompsRequest) (*CompsResult, error) {
-	values := url.Values{
-		zwsIdParam:         {z.zwsId},
-		zpidParam:          {request.Zpid},
-		countParam:         {strconv.Itoa(request.Count)},
-		rentzestimateParam: {strconv.FormatBool(request.Rentzestimate)},
-	}
-	var result CompsResult
-	if err := z.get(getComps, values, &result); err != nil {
-		return nil, err
-	} else {
-		return &result, nil
-	}
-}